@@ -1,10 +1,101 @@
+function output_format() {
+    if [ "${GITHUB_ACTIONS}" == "true" ]; then
+        env_or_default OUTPUT_FORMAT github
+    else
+        env_or_default OUTPUT_FORMAT plain
+    fi
+}
+
+function log_error() {
+    case "$(output_format)" in
+        github)
+            echo "::error::${1}" >&2
+            ;;
+        color)
+            echo -e "\033[31mError: ${1}\033[0m" >&2
+            ;;
+        *)
+            echo "Error: ${1}" >&2
+            ;;
+    esac
+}
+
+function log_warning() {
+    case "$(output_format)" in
+        github)
+            echo "::warning::${1}" >&2
+            ;;
+        color)
+            echo -e "\033[33mWarning: ${1}\033[0m" >&2
+            ;;
+        *)
+            echo "Warning: ${1}" >&2
+            ;;
+    esac
+}
+
 function require_command() {
-    if ! [ -x "$(command -v ${1})" ]; then
-        echo "Error: '${1}' is not installed." >&2
-        exit 1
+    missing=0
+    for cmd in "$@"; do
+        if [ -z "$(type -P ${cmd})" ]; then
+            log_error "'${cmd}' is not installed."
+            missing=1
+        fi
+    done
+
+    if [ ${missing} != 0 ]; then
+        exit 3
+    fi
+}
+
+function require_any_command() {
+    for cmd in "$@"; do
+        if [ ! -z "$(type -P ${cmd})" ]; then
+            return 0
+        fi
+    done
+
+    log_error "none of '$*' is installed."
+    exit 3
+}
+
+function require_docker() {
+    require_command docker
+    if ! docker info > /dev/null 2>&1; then
+        log_error "the docker daemon is not reachable."
+        exit 3
+    fi
+}
+
+function require_disk_space() {
+    # ${1}: a directory on the filesystem that will hold the cluster
+    min_free=$(env_or_default MIN_FREE_DISK_MB 5120)
+    if [ ! -d "${1}" ]; then
+        # ie. docker's data root lives in a VM on macOS
+        return 0
+    fi
+
+    free=$(df -Pk "${1}" | awk 'NR == 2 { print int($4 / 1024) }')
+    if [ "${free}" -lt "${min_free}" ]; then
+        log_error "only ${free}MB free on '${1}', at least ${min_free}MB needed."
+        exit 3
+    fi
+}
+
+function require_python_module() {
+    require_command python3
+    if ! python3 -c "import ${1}" 2> /dev/null; then
+        log_error "python module '${1}' is not installed."
+        exit 3
     fi
 }
 
+function require_test_dependencies() {
+    # kubectl, git and python3 (with PyYAML) are used by the catalog's test runner
+    require_command kubectl git python3 "$@"
+    require_python_module yaml
+}
+
 function env_or_default() {
     if [ ! -z "${!1}" ]; then
         echo ${!1}
@@ -43,9 +134,87 @@ function get_pipelines() {
 
 function create_tmpdir() {
     if [ -z "${1}" ]; then
-        echo "creating a temp dir is missing prefix"
+        log_error "creating a temp dir is missing prefix"
         exit 4
     fi
 
     echo $(mktemp -d "${TMPDIR:-/tmp}/${1}.XXXXXXXXX")
-}
\ No newline at end of file
+}
+
+function pause_on_failure() {
+    if [ "${1}" == "0" ] || [ "$(env_or_default PAUSE_ON_FAILURE false)" != "true" ]; then
+        return 0
+    fi
+
+    KUBECTLCMD=$(env_or_default KUBECTLCMD kubectl)
+    timeout=$(env_or_default PAUSE_ON_FAILURE_TIMEOUT 1800)
+
+    echo "> Run failed (exit code: ${1}), pausing before cleanup..."
+    echo "--> Context: $($KUBECTLCMD config current-context || true)"
+    echo "--> Kubeconfig: ${KUBECONFIG:-${HOME}/.kube/config}"
+    echo "--> Inspect runs with: $KUBECTLCMD get pipelineruns,taskruns --all-namespaces"
+    read -t ${timeout} -p "> Press [Enter] to continue with cleanup (timeout: ${timeout}s)..." || true
+    echo
+}
+
+function should_cleanup() {
+    policy=$(env_or_default CLEANUP_POLICY always)
+    case "${policy}" in
+        always)
+            return 0
+            ;;
+        on-success)
+            [ "${1}" == "0" ]
+            ;;
+        never)
+            return 1
+            ;;
+        *)
+            log_warning "Unknown CLEANUP_POLICY '${policy}' (expected: always, on-success, never), cleaning up..."
+            return 0
+            ;;
+    esac
+}
+
+function pause_tracing() {
+    # the trace goes to fd 9, silence it for the "set +x" itself
+    { TRACING=$-; set +x; } 9> /dev/null
+}
+
+function resume_tracing() {
+    if [[ "${TRACING}" == *x* ]]; then
+        set -x
+    fi
+}
+
+function trace_command() {
+    { local tracing=$-; set +x; } 9> /dev/null
+    local start=${EPOCHREALTIME:-${SECONDS}000000}
+    local status=0
+    command "$@" || status=$?
+
+    if [[ "${tracing}" == *x* ]]; then
+        local end=${EPOCHREALTIME:-${SECONDS}000000}
+        local duration=$(( (${end//[!0-9]/} - ${start//[!0-9]/}) / 1000 ))
+        printf '+ %(%H:%M:%S)T %s:%s: %s (exit code: %s, duration: %sms)\n' -1 "${BASH_SOURCE[2]}" "${BASH_LINENO[1]}" "$*" ${status} ${duration} >&9
+        set -x
+    fi
+    return ${status}
+}
+
+if [ "$(env_or_default TRACE_COMMANDS false)" == "true" ]; then
+    PS4='+ \D{%H:%M:%S} ${BASH_SOURCE}:${LINENO}: '
+    if [ ! -z "${TRACE_FILE}" ]; then
+        exec 9>> "${TRACE_FILE}"
+    else
+        exec 9>&2
+    fi
+    BASH_XTRACEFD=9
+
+    # log the exit code and duration of the external commands
+    for cmd in kubectl oc kind minikube microk8s crc gcloud terraform git docker; do
+        eval "function ${cmd}() { trace_command ${cmd} \"\$@\"; }"
+    done
+
+    set -x
+fi
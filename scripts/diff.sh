@@ -10,11 +10,11 @@ source "${DIR}/_common.sh"
 # CONFIGURATION
 
 DIFFCMD=$(env_or_default DIFFCMD "git diff --no-index")
+CATALOG_REPO=$(env_or_default CATALOG_REPO https://github.com/tektoncd/catalog)
 
 # DEPENDENCIES
 
-require_command git
-require_command $DIFFCMD
+require_command git ${DIFFCMD%% *}
 
 # FUNCTIONS
 
@@ -25,7 +25,7 @@ function diff_resources() {
     for a in $resources; do
         parts=(${a//:/ })
         if [ ${#parts[@]} != 2 ];then
-            echo "Couldn't parse '${a}'. Make sure to provide a version (ie. 'my-${type}:0.2')"
+            log_error "Couldn't parse '${a}'. Make sure to provide a version (ie. 'my-${type}:0.2')"
             exit 2
         fi
 
@@ -43,7 +43,7 @@ function diff_resources() {
 tmp_dir=$(create_tmpdir diff)
 
 echo "> Downloading catalog..."
-git clone https://github.com/tektoncd/catalog ${tmp_dir}
+git clone ${CATALOG_REPO} ${tmp_dir}
 
 tasks_dir="${DIR}/../task"
 tasks=$(get_tasks ${tasks_dir})
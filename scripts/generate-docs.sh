@@ -18,12 +18,7 @@ RESOURCE_DIR=$(realpath "${DIR}/../$1")
 
 # DEPENDENCIES
 
-require_command jq
-require_command yj
-require_command yq
-require_command envsubst
-require_command pack
-require_command crane
+require_command jq yj yq envsubst pack crane
 
 # TASK
 
@@ -33,13 +28,13 @@ export VERSION=$(echo "$RESOURCE_DIR" | rev | cut -d'/' -f1 | rev)
 
 DEFINITION="${RESOURCE_DIR}/${NAME}.yaml"
 if [ ! -f "$DEFINITION" ]; then
-    echo "${TYPE} definition '$DEFINITION' not found!"
+    log_error "${TYPE} definition '$DEFINITION' not found!"
 fi
 
 BASE_TEMPLATE_FILE="${TYPE}/${NAME}/${VERSION}/README.tpl.md"
 TEMPLATE_FILE="${DIR}/../${BASE_TEMPLATE_FILE}"
 if [ ! -f "$TEMPLATE_FILE" ]; then
-    echo "README template '$TEMPLATE_FILE' not found!"
+    log_error "README template '$TEMPLATE_FILE' not found!"
 fi
 
 echo "> Gathering suggested builders..."
@@ -10,16 +10,49 @@ source "${DIR}/../_common.sh"
 # CONFIGURATION
 
 KUBECTLCMD=$(env_or_default KUBECTLCMD kubectl)
+TEKTON_RELEASE=$(env_or_default TEKTON_RELEASE https://storage.googleapis.com/tekton-releases/pipeline/latest/release.yaml)
+ADDONS_TIMEOUT=$(env_or_default ADDONS_TIMEOUT 300s)
 
 # DEPENDENCIES
 
 require_command $KUBECTLCMD
 
+# INPUT
+
+if [ ! -z "${TEKTON_CONFIG_DEFAULTS}" ] && [ ! -f "${TEKTON_CONFIG_DEFAULTS}" ]; then
+    log_error "File '${TEKTON_CONFIG_DEFAULTS}' not found!"
+    exit 1
+fi
+
 # TASK
 
 echo "> Installing tekton..."
-$KUBECTLCMD apply --filename https://storage.googleapis.com/tekton-releases/pipeline/latest/release.yaml
+$KUBECTLCMD apply --filename ${TEKTON_RELEASE}
 
 echo "> Waiting for pods to be ready..."
 sleep 15
 $KUBECTLCMD wait --for=condition=ready -n tekton-pipelines pods --timeout=120s --all
+
+if [ ! -z "${TEKTON_CONFIG_DEFAULTS}" ]; then
+    echo "> Configuring tekton defaults (${TEKTON_CONFIG_DEFAULTS})..."
+    $KUBECTLCMD patch configmap config-defaults -n tekton-pipelines --type merge --patch "$(cat ${TEKTON_CONFIG_DEFAULTS})"
+fi
+
+if [ ! -z "${ADDONS}" ]; then
+    echo "> Installing add-ons..."
+    for addon in ${ADDONS//;/ }; do
+        echo "--> Installing ${addon}..."
+        $KUBECTLCMD apply --filename ${addon}
+
+        # wait for the workloads of this add-on before applying the next one, which may depend on it
+        echo "--> Waiting for ${addon} to be ready..."
+        resources=$($KUBECTLCMD get --filename ${addon} --no-headers --output custom-columns=KIND:.kind,NAMESPACE:.metadata.namespace,NAME:.metadata.name)
+        while read kind namespace name; do
+            case "${kind}" in
+                Deployment|DaemonSet|StatefulSet)
+                    $KUBECTLCMD rollout status --namespace ${namespace} --timeout ${ADDONS_TIMEOUT} ${kind,,}/${name}
+                    ;;
+            esac
+        done <<< "${resources}"
+    done
+fi
@@ -7,16 +7,48 @@ set -e
 DIR="$(dirname "${BASH_SOURCE[0]}")"
 source "${DIR}/../_common.sh"
 
+# CONFIGURATION
+
+KUBECTLCMD=$(env_or_default KUBECTLCMD kubectl)
+CATALOG_REPO=$(env_or_default CATALOG_REPO https://github.com/tektoncd/catalog)
+TEST_TIMEOUT=$(env_or_default TEST_TIMEOUT "")
+QUARANTINE=$(env_or_default QUARANTINE "")
+
 # DEPENDENCIES
 
-require_command kubectl
-require_command git
+require_test_dependencies
+if [ "${KUBECTLCMD}" != "kubectl" ]; then
+    require_command $KUBECTLCMD
+fi
+if [ ! -z "${TEST_TIMEOUT}" ]; then
+    require_command timeout
+fi
+
+# FUNCTIONS
+
+function collect_timed_out_test() {
+    # ${1}: namespace of the test, created by the catalog runner
+    echo "--> Runs and pods:"
+    $KUBECTLCMD get pipelineruns,taskruns,pods --all-namespaces || true
+
+    echo "--> Describing runs and pods in '${1}'..."
+    $KUBECTLCMD describe pipelineruns,taskruns,pods --namespace ${1} || true
+
+    for pod in $($KUBECTLCMD get pods --namespace ${1} --output name || true); do
+        echo "--> Logs of '${pod}' in '${1}'..."
+        $KUBECTLCMD logs ${pod} --namespace ${1} --all-containers --prefix || true
+    done
+
+    # the runner was killed before it could clean up, free the capacity for the next tests
+    echo "--> Deleting namespace '${1}'..."
+    $KUBECTLCMD delete namespace ${1} --ignore-not-found --wait=false || true
+}
 
 # INPUT
 
 if [ "$1" = "" ];then
-  echo "Usage: ${BASH_SOURCE[0]} <task-name:version>..."
-  exit 1
+  log_error "Usage: ${BASH_SOURCE[0]} <task-name:version>..."
+  exit 2
 fi
 
 # TASK
@@ -24,24 +56,84 @@ fi
 tmp_dir=$(create_tmpdir e2e-test)
 
 echo "> Downloading catalog..."
-git clone https://github.com/tektoncd/catalog ${tmp_dir}
+git clone ${CATALOG_REPO} ${tmp_dir} || exit 12
 
 echo "> Coping/Overlaying dev tasks..."
 cp -vR ${DIR}/../../task/* ${tmp_dir}/task/
 
+runner=()
+if [ ! -z "${TEST_TIMEOUT}" ]; then
+    runner=(timeout ${TEST_TIMEOUT})
+fi
+
+failed=()
+timed_out=()
+quarantined=()
+left_behind=()
+
 pushd ${tmp_dir} > /dev/null
 
     for a in "$@"; do
         parts=(${a//:/ })
         if [ ${#parts[@]} != 2 ];then
-            echo "Couldn't parse '${a}'. Make sure to provide a version (ie. 'my-task:0.2')"
+            log_error "Couldn't parse '${a}'. Make sure to provide a version (ie. 'my-task:0.2')"
             exit 2
         fi
 
+        # created by the catalog runner, which deletes it only when the test passes
+        namespace=${parts[0]}-${parts[1]//./-}
+
         echo "> Running test for '${a}'..."
-        ./test/run-test.sh ${parts[0]} ${parts[1]}
+        status=0
+        "${runner[@]}" ./test/run-test.sh ${parts[0]} ${parts[1]} || status=$?
+
+        if [ ! -z "${TEST_TIMEOUT}" ] && [ ${status} == 124 ]; then
+            echo "> Test for '${a}' timed out after ${TEST_TIMEOUT}, collecting state..."
+            collect_timed_out_test ${namespace}
+        fi
+
+        if [ ${status} != 0 ] && [[ ";${QUARANTINE};" == *";${a};"* ]]; then
+            log_warning "Test for '${a}' failed (exit code: ${status}) but is quarantined."
+            quarantined+=("${a}")
+        elif [ ! -z "${TEST_TIMEOUT}" ] && [ ${status} == 124 ]; then
+            log_error "Test for '${a}' timed out after ${TEST_TIMEOUT}."
+            timed_out+=("${a}")
+        elif [ ${status} != 0 ]; then
+            log_error "Test for '${a}' failed (exit code: ${status})."
+            failed+=("${a}")
+        fi
+
+        if [ "$($KUBECTLCMD get namespace ${namespace} --ignore-not-found --output jsonpath='{.status.phase}')" == "Active" ]; then
+            left_behind+=("${namespace}")
+        fi
         echo
         echo
     done
 
-popd > /dev/null
\ No newline at end of file
+popd > /dev/null
+
+if [ ${#quarantined[@]} != 0 ]; then
+    echo "> Quarantined: ${quarantined[*]}"
+fi
+
+if [ ${#timed_out[@]} != 0 ]; then
+    echo "> Timed out: ${timed_out[*]}"
+fi
+
+if [ ${#left_behind[@]} != 0 ]; then
+    echo "> Left behind: ${left_behind[*]}"
+    for namespace in "${left_behind[@]}"; do
+        echo "--> Resources in '${namespace}':"
+        $KUBECTLCMD get pipelineruns,taskruns,pods,persistentvolumeclaims,secrets --namespace ${namespace} || true
+    done
+    echo "--> Delete them with: $KUBECTLCMD delete namespace ${left_behind[*]}"
+fi
+
+if [ ${#failed[@]} != 0 ]; then
+    echo "> Failed: ${failed[*]}"
+    exit 1
+fi
+
+if [ ${#timed_out[@]} != 0 ]; then
+    exit 124
+fi
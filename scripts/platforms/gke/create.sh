@@ -9,9 +9,7 @@ source "${DIR}/../../_common.sh"
 
 # DEPENDENCIES
 
-require_command kubectl
-require_command gcloud
-require_command terraform
+require_command kubectl gcloud terraform
 
 # INPUTS
 
@@ -7,6 +7,10 @@ set -e
 DIR="$(dirname "${BASH_SOURCE[0]}")"
 source "${DIR}/../../_common.sh"
 
+# DEPENDENCIES
+
+require_test_dependencies gcloud terraform openssl
+
 # INPUTS
 
 cluster_name="test-$(openssl rand -hex 12)"
@@ -14,18 +18,27 @@ cluster_name="test-$(openssl rand -hex 12)"
 # CLEANUP
 
 function cleanup {
-    ${DIR}/destroy.sh ${cluster_name}
+    status=$?
+    pause_on_failure ${status}
+    if should_cleanup ${status}; then
+        # keep the run's exit code even if the teardown fails
+        ${DIR}/destroy.sh ${cluster_name} || log_error "Teardown failed, retry it with: ${DIR}/destroy.sh ${cluster_name}"
+    else
+        echo "> Keeping cluster (${cluster_name}), delete it with: ${DIR}/destroy.sh ${cluster_name}"
+    fi
+
+    exit ${status}
 }
 
 trap cleanup EXIT
 
 # CREATE
 
-${DIR}/create.sh ${cluster_name}
+${DIR}/create.sh ${cluster_name} || exit 10
 
 # SETUP
 
-${DIR}/../1_k8s_setup.sh
+${DIR}/../1_k8s_setup.sh || exit 11
 
 # TEST
 
@@ -23,5 +23,13 @@ fi
 
 ## create cluster
 
+args=(--name ${cluster_name})
+if [ ! -z "${KIND_NODE_IMAGE}" ]; then
+    args+=(--image ${KIND_NODE_IMAGE})
+fi
+if [ ! -z "${KIND_CONFIG}" ]; then
+    args+=(--config ${KIND_CONFIG})
+fi
+
 echo "> Starting a new cluster (${cluster_name})..."
-kind create cluster --name ${cluster_name}
\ No newline at end of file
+kind create cluster "${args[@]}"
\ No newline at end of file
@@ -0,0 +1,59 @@
+#!/usr/bin/env bash
+
+set -e
+
+# IMPORTS
+
+DIR="$(dirname "${BASH_SOURCE[0]}")"
+source "${DIR}/../../_common.sh"
+
+# DEPENDENCIES
+
+require_command microk8s
+
+# CONFIGURATION
+
+MICROK8S_ALLOW_EXISTING=$(env_or_default MICROK8S_ALLOW_EXISTING false)
+
+# INPUTS
+
+if [ "$1" = "" ];then
+  echo "Usage: ${BASH_SOURCE[0]} <state-dir>"
+  exit 1
+fi
+
+state_dir=$1
+
+# TASK
+
+## start cluster
+
+# MicroK8s is a single instance per host, remember its state so that destroy.sh only undoes this run
+if microk8s status | grep -q "^microk8s is running"; then
+    touch ${state_dir}/was-running
+else
+    echo "> Starting cluster..."
+    microk8s start
+fi
+microk8s status --wait-ready
+
+## check for existing workloads
+
+microk8s kubectl get namespaces --no-headers --output custom-columns=NAME:.metadata.name > ${state_dir}/namespaces
+existing=$(grep -v -x -e default -e kube-system -e kube-public -e kube-node-lease ${state_dir}/namespaces || true)
+existing_pods=$(microk8s kubectl get pods --namespace default --no-headers --output name)
+if [ ! -z "${existing}${existing_pods}" ] && [ "${MICROK8S_ALLOW_EXISTING}" != "true" ]; then
+    log_error "MicroK8s already runs workloads ($(echo ${existing} ${existing_pods})), set MICROK8S_ALLOW_EXISTING=true to run the tests on it anyway."
+    exit 1
+fi
+
+# 1_k8s_setup.sh applies them cluster-wide, destroy.sh removes them again
+echo "${ADDONS}" > ${state_dir}/addons
+
+echo "> Enabling add-ons..."
+microk8s enable dns
+# the storage add-on was renamed to hostpath-storage in MicroK8s 1.24
+microk8s enable hostpath-storage || microk8s enable storage
+
+echo "> Writing kubeconfig (${state_dir}/kubeconfig)..."
+microk8s config > ${state_dir}/kubeconfig
@@ -0,0 +1,54 @@
+#!/usr/bin/env bash
+
+set -e
+
+# IMPORTS
+
+DIR="$(dirname "${BASH_SOURCE[0]}")"
+source "${DIR}/../../_common.sh"
+
+# DEPENDENCIES
+
+require_command microk8s
+
+# INPUTS
+
+if [ "$1" = "" ];then
+  echo "Usage: ${BASH_SOURCE[0]} <state-dir>"
+  exit 1
+fi
+
+state_dir=$1
+
+# TASK
+
+if [ -f ${state_dir}/namespaces ]; then
+    created=$(microk8s kubectl get namespaces --no-headers --output custom-columns=NAME:.metadata.name | grep -v -x -f ${state_dir}/namespaces || true)
+
+    # only recorded once create.sh accepted the instance, in reverse order as later add-ons may depend on earlier ones
+    if [ -f ${state_dir}/addons ]; then
+        addons=$(cat ${state_dir}/addons)
+        addons=(${addons//;/ })
+        for (( i = ${#addons[@]} - 1; i >= 0; i-- )); do
+            echo "> Removing add-on ${addons[i]}..."
+            microk8s kubectl delete --ignore-not-found --filename ${addons[i]}
+        done
+    fi
+
+    if echo "${created}" | grep -q -x tekton-pipelines; then
+        echo "> Removing tekton..."
+        microk8s kubectl delete clusterroles,clusterrolebindings,customresourcedefinitions,mutatingwebhookconfigurations,validatingwebhookconfigurations --selector app.kubernetes.io/part-of=tekton-pipelines
+    fi
+
+    if [ ! -z "${created}" ]; then
+        echo "> Deleting namespaces created by the run ($(echo ${created}))..."
+        microk8s kubectl delete namespaces ${created}
+    fi
+
+    if [ ! -f ${state_dir}/was-running ]; then
+        echo "> Stopping cluster..."
+        microk8s stop
+    fi
+fi
+
+rm -rf ${state_dir}
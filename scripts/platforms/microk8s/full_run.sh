@@ -0,0 +1,48 @@
+#!/usr/bin/env bash
+
+set -e
+
+# IMPORTS
+
+DIR="$(dirname "${BASH_SOURCE[0]}")"
+source "${DIR}/../../_common.sh"
+
+# DEPENDENCIES
+
+require_test_dependencies microk8s
+require_disk_space /var/snap/microk8s
+
+# INPUTS
+
+state_dir=$(create_tmpdir microk8s)
+export KUBECONFIG="${state_dir}/kubeconfig"
+
+# CLEANUP
+
+function cleanup {
+    status=$?
+    pause_on_failure ${status}
+    if should_cleanup ${status}; then
+        # keep the run's exit code even if the teardown fails
+        ${DIR}/destroy.sh ${state_dir} || log_error "Teardown failed, retry it with: ${DIR}/destroy.sh ${state_dir}"
+    else
+        echo "> Keeping cluster, clean it up with: ${DIR}/destroy.sh ${state_dir}"
+        echo "--> Kubeconfig: ${KUBECONFIG}"
+    fi
+
+    exit ${status}
+}
+
+trap cleanup EXIT
+
+# CREATE
+
+${DIR}/create.sh ${state_dir} || exit 10
+
+# SETUP
+
+${DIR}/../1_k8s_setup.sh || exit 11
+
+# TEST
+
+${DIR}/../2_run_tests.sh $(get_tasks "${DIR}/../../../task")
\ No newline at end of file
@@ -0,0 +1,27 @@
+#!/usr/bin/env bash
+
+set -e
+
+# IMPORTS
+
+DIR="$(dirname "${BASH_SOURCE[0]}")"
+source "${DIR}/../../_common.sh"
+
+# DEPENDENCIES
+
+require_command minikube
+
+# INPUTS
+
+if [ "$1" = "" ];then
+    cluster_name="test-$(openssl rand -hex 12)"
+else
+    cluster_name=$1
+fi
+
+# TASK
+
+## create cluster
+
+echo "> Starting a new cluster (${cluster_name})..."
+minikube start --profile ${cluster_name}
\ No newline at end of file
@@ -0,0 +1,64 @@
+#!/usr/bin/env bash
+
+set -e
+
+# IMPORTS
+
+DIR="$(dirname "${BASH_SOURCE[0]}")"
+source "${DIR}/../../_common.sh"
+
+# DEPENDENCIES
+
+require_test_dependencies minikube openssl
+# minikube picks a driver itself unless MINIKUBE_DRIVER is set
+case "${MINIKUBE_DRIVER}" in
+    "")
+        require_any_command docker podman VBoxManage virsh hyperkit vmrun prlctl
+        ;;
+    docker)
+        require_docker
+        ;;
+    podman|hyperkit)
+        require_command ${MINIKUBE_DRIVER}
+        ;;
+    virtualbox)
+        require_command VBoxManage
+        ;;
+    kvm2)
+        require_command virsh
+        ;;
+esac
+require_disk_space "${MINIKUBE_HOME:-${HOME}}"
+
+# INPUTS
+
+cluster_name="test-$(openssl rand -hex 12)"
+
+# CLEANUP
+
+function cleanup {
+    status=$?
+    pause_on_failure ${status}
+    if should_cleanup ${status}; then
+        # keep the run's exit code even if the teardown fails
+        ${DIR}/destroy.sh ${cluster_name} || log_error "Teardown failed, retry it with: ${DIR}/destroy.sh ${cluster_name}"
+    else
+        echo "> Keeping cluster (${cluster_name}), delete it with: ${DIR}/destroy.sh ${cluster_name}"
+    fi
+
+    exit ${status}
+}
+
+trap cleanup EXIT
+
+# CREATE
+
+${DIR}/create.sh ${cluster_name} || exit 10
+
+# SETUP
+
+${DIR}/../1_k8s_setup.sh || exit 11
+
+# TEST
+
+${DIR}/../2_run_tests.sh $(get_tasks "${DIR}/../../../task")
\ No newline at end of file
@@ -5,8 +5,13 @@ function login_as_developer() {
 }
 
 function login_as_admin() {
+    # keep the admin credentials out of the command trace
+    pause_tracing
     local url=$(crc console --credentials -o json | jq -r .clusterConfig.url)
     local admin_username=$(crc console --credentials -o json | jq -r .clusterConfig.adminCredentials.username)
     local admin_password=$(crc console --credentials -o json | jq -r .clusterConfig.adminCredentials.password)
-    oc login -u ${admin_username} -p ${admin_password} https://api.crc.testing:6443
+    local status=0
+    oc login -u ${admin_username} -p ${admin_password} https://api.crc.testing:6443 || status=$?
+    resume_tracing
+    return ${status}
 }
\ No newline at end of file
@@ -10,9 +10,7 @@ source "${DIR}/_common.sh"
 
 # DEPENDENCIES
 
-require_command crc
-require_command jq
-require_command oc
+require_command crc jq oc
 
 # TASK
 
@@ -8,23 +8,36 @@ DIR="$(dirname "${BASH_SOURCE[0]}")"
 source "${DIR}/../../_common.sh"
 source "${DIR}/_common.sh"
 
+# DEPENDENCIES
+
+require_test_dependencies crc jq oc
+
 # CLEANUP
 
 function cleanup {
-    ${DIR}/destroy.sh
+    status=$?
+    pause_on_failure ${status}
+    if should_cleanup ${status}; then
+        # keep the run's exit code even if the teardown fails
+        ${DIR}/destroy.sh || log_error "Teardown failed, retry it with: ${DIR}/destroy.sh"
+    else
+        echo "> Keeping cluster, delete it with: ${DIR}/destroy.sh"
+    fi
+
+    exit ${status}
 }
 
 trap cleanup EXIT
 
 # CREATE
 
-${DIR}/create.sh
+${DIR}/create.sh || exit 10
 
 # SETUP
 
-login_as_admin
+login_as_admin || exit 11
 
-${DIR}/../1_k8s_setup.sh
+${DIR}/../1_k8s_setup.sh || exit 11
 
 # TEST
 
@@ -16,7 +16,7 @@ fi
 
 RESOURCE_DIR=$(realpath "$1")
 if [ ! -d "$RESOURCE_DIR" ]; then
-    echo "$RESOURCE_DIR not found!"
+    log_error "$RESOURCE_DIR not found!"
 fi
 
 CATALOG_DIR=$(realpath "$2")
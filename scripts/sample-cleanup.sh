@@ -16,7 +16,7 @@ fi
 
 SAMPLE_FILE="$1"
 if [ ! -f "$SAMPLE_FILE" ]; then
-    echo "File '$SAMPLE_FILE' not found!"
+    log_error "File '$SAMPLE_FILE' not found!"
 fi
 
 # DEPENDENCIES
@@ -16,7 +16,7 @@ fi
 
 SAMPLE_FILE="$1"
 if [ ! -f "$SAMPLE_FILE" ]; then
-    echo "File '$SAMPLE_FILE' not found!"
+    log_error "File '$SAMPLE_FILE' not found!"
     exit 1
 fi
 
@@ -24,8 +24,7 @@ IMAGE_NAME="$2"
 
 # DEPENDENCIES
 
-require_command kubectl
-require_command tkn
+require_command kubectl tkn
 
 # TASK
 